@@ -0,0 +1,94 @@
+package bootstrap
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/0xPolygon/polygon-edge/crypto"
+	"github.com/0xPolygon/polygon-edge/secrets/helper"
+	"github.com/spf13/cobra"
+
+	availsecrets "github.com/maticnetwork/avail-settlement/pkg/secrets"
+	"github.com/maticnetwork/avail-settlement/pkg/staking"
+)
+
+// GetCommand returns the "avail bootstrap" command. It's the explicit,
+// one-shot replacement for the stake-on-every-startup hack that used to
+// live in consensus/avail.Start(): it stakes the node's sequencer key if
+// it isn't staked yet and exits, so the consensus start-up path itself
+// stays deterministic and idempotent across restarts.
+func GetCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "bootstrap",
+		Short: "Stakes this node's sequencer key, if it isn't staked yet",
+		RunE:  run,
+	}
+
+	setFlags(cmd)
+
+	return cmd
+}
+
+const (
+	dataDirFlag     = "data-dir"
+	stakeAmountFlag = "stake-amount"
+)
+
+func setFlags(cmd *cobra.Command) {
+	cmd.Flags().String(dataDirFlag, "", "the directory the node's local chain and SecretsManager live in")
+	cmd.Flags().Int64(stakeAmountFlag, 10, "the amount, in ETH, to stake if the sequencer isn't staked yet")
+}
+
+func run(cmd *cobra.Command, _ []string) error {
+	dataDir, err := cmd.Flags().GetString(dataDirFlag)
+	if err != nil {
+		return err
+	}
+
+	stakeETH, err := cmd.Flags().GetInt64(stakeAmountFlag)
+	if err != nil {
+		return err
+	}
+
+	manager, err := helper.SetupLocalSecretsManager(dataDir)
+	if err != nil {
+		return fmt.Errorf("failed to set up the secrets manager: %w", err)
+	}
+
+	sequencerKey, err := availsecrets.GetECDSAKey(manager, availsecrets.SequencerKey)
+	if err != nil {
+		return fmt.Errorf("failed to load sequencer key: %w", err)
+	}
+
+	sequencerAddr := crypto.PubKeyToAddress(&sequencerKey.PublicKey)
+
+	// openLocalChain opens the same storage the node itself opens at
+	// start-up; nothing here guards against the node having it open at the
+	// same time, so bootstrap runs before the node is started.
+	bchain, executor, logger, err := openLocalChain(dataDir)
+	if err != nil {
+		return fmt.Errorf("failed to open local chain: %w", err)
+	}
+
+	querier := staking.NewActiveSequencersQuerier(bchain, executor, logger)
+
+	staked, err := querier.Contains(sequencerAddr)
+	if err != nil {
+		return fmt.Errorf("failed to check sequencer stake: %w", err)
+	}
+
+	if staked {
+		cmd.Println("sequencer already staked, nothing to do")
+		return nil
+	}
+
+	stakeAmount := big.NewInt(0).Mul(big.NewInt(stakeETH), staking.ETH)
+
+	if err := staking.Stake(bchain, executor, logger, "sequencer", sequencerAddr, sequencerKey, stakeAmount, 1_000_000, "sequencer"); err != nil {
+		return fmt.Errorf("failed to stake sequencer: %w", err)
+	}
+
+	cmd.Println("sequencer staked")
+
+	return nil
+}
@@ -0,0 +1,21 @@
+package bootstrap
+
+import (
+	"github.com/0xPolygon/polygon-edge/blockchain"
+	"github.com/0xPolygon/polygon-edge/state"
+	"github.com/hashicorp/go-hclog"
+)
+
+// openLocalChain opens the chain and state storage under dataDir the same
+// way the node itself does at start-up, so "avail bootstrap" can stake a
+// sequencer offline, before the node process is ever started.
+func openLocalChain(dataDir string) (*blockchain.Blockchain, *state.Executor, hclog.Logger, error) {
+	logger := hclog.New(&hclog.LoggerOptions{Name: "bootstrap"})
+
+	bchain, executor, err := blockchain.OpenStorage(dataDir, logger)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	return bchain, executor, logger, nil
+}
@@ -0,0 +1,79 @@
+package init
+
+import (
+	"fmt"
+
+	"github.com/0xPolygon/polygon-edge/secrets"
+	"github.com/0xPolygon/polygon-edge/secrets/helper"
+	availsecrets "github.com/maticnetwork/avail-settlement/pkg/secrets"
+	"github.com/spf13/cobra"
+)
+
+// GetCommand returns the "avail secrets init" command, the Avail
+// counterpart to polygon-edge's own "secrets init": it generates the
+// sequencer, watch tower and Avail-signing keys (see pkg/secrets) through
+// whichever SecretsManager backend (local, Vault, AWS Secrets Manager, ...)
+// the node is configured with, so none of them ever need to live in a
+// plaintext keystore file.
+func GetCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "init",
+		Short: "Generates the sequencer, watch tower and Avail signing keys through the configured SecretsManager",
+		RunE:  run,
+	}
+
+	setFlags(cmd)
+
+	return cmd
+}
+
+func setFlags(cmd *cobra.Command) {
+	cmd.Flags().String(
+		secretsConfigFlag,
+		"",
+		"the path to the SecretsManager config file, if using a non-local backend",
+	)
+
+	cmd.Flags().String(
+		dataDirFlag,
+		"",
+		"the directory for the local SecretsManager backend",
+	)
+}
+
+const (
+	secretsConfigFlag = "secrets-config"
+	dataDirFlag       = "data-dir"
+)
+
+func run(cmd *cobra.Command, _ []string) error {
+	secretsConfigPath, err := cmd.Flags().GetString(secretsConfigFlag)
+	if err != nil {
+		return err
+	}
+
+	dataDir, err := cmd.Flags().GetString(dataDirFlag)
+	if err != nil {
+		return err
+	}
+
+	var manager secrets.SecretsManager
+
+	if secretsConfigPath != "" {
+		manager, err = helper.SetupSecretsManager(secretsConfigPath)
+	} else {
+		manager, err = helper.SetupLocalSecretsManager(dataDir)
+	}
+
+	if err != nil {
+		return fmt.Errorf("failed to set up the secrets manager: %w", err)
+	}
+
+	if err := availsecrets.InitAvailKeys(manager); err != nil {
+		return fmt.Errorf("failed to initialize avail keys: %w", err)
+	}
+
+	cmd.Println("Avail sequencer, watch tower and Avail-signing keys initialized")
+
+	return nil
+}
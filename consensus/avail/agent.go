@@ -0,0 +1,123 @@
+package avail
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"fmt"
+	"time"
+
+	"github.com/0xPolygon/polygon-edge/crypto"
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/hashicorp/go-hclog"
+)
+
+// Agent consumes the sealTasks a worker assembles and turns each into a
+// sealed block, ready to be written to the local chain. Swapping the Agent
+// is what lets the same worker feed Avail in production and a
+// LocalSealAgent in devnet/integration tests, without a live Avail node,
+// and gives retry/backoff on submission failure a single place to live.
+type Agent interface {
+	// Seal takes a fully assembled, unsealed block and returns its sealed
+	// counterpart once it's been committed wherever this Agent posts it.
+	Seal(ctx context.Context, block *types.Block) (*types.Block, error)
+}
+
+// AvailDAAgent posts the block payload to Avail and waits for its
+// inclusion before returning a sealed block.
+type AvailDAAgent struct {
+	d        *Avail
+	logger   hclog.Logger
+	minerKey *ecdsa.PrivateKey
+
+	// retryBackoff is how long to wait between resubmission attempts if
+	// posting to Avail fails.
+	retryBackoff time.Duration
+	maxRetries   int
+}
+
+// NewAvailDAAgent creates the Agent sequencers use in production. Blocks
+// are signed with minerKey before being submitted, so the signature travels
+// to Avail alongside the block itself.
+func NewAvailDAAgent(d *Avail, minerKey *ecdsa.PrivateKey) *AvailDAAgent {
+	return &AvailDAAgent{
+		d:            d,
+		logger:       d.logger.Named("availda-agent"),
+		minerKey:     minerKey,
+		retryBackoff: time.Second,
+		maxRetries:   5,
+	}
+}
+
+func (a *AvailDAAgent) Seal(ctx context.Context, block *types.Block) (*types.Block, error) {
+	block.Header.ComputeHash()
+
+	sig, err := crypto.Sign(a.minerKey, block.Header.Hash.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("failed to sign block %d: %w", block.Header.Number, err)
+	}
+
+	// The signature rides alongside the block in the Avail payload, not in
+	// ExtraData: ExtraData is reserved for the Avail inclusion proof set
+	// below, which availproc.Processor.PreCommitState checks against on
+	// every node that later processes this block.
+	payload := append(block.MarshalRLP(), sig...)
+
+	var blockHash types.Hash
+
+	for attempt := 0; attempt <= a.maxRetries; attempt++ {
+		blockHash, err = a.d.availClient.SubmitData(payload)
+		if err == nil {
+			break
+		}
+
+		a.logger.Error("failed to submit block to avail, retrying", "number", block.Header.Number, "attempt", attempt, "err", err)
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(a.retryBackoff):
+		}
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to submit block %d to avail after %d attempts: %w", block.Header.Number, a.maxRetries+1, err)
+	}
+
+	if err := a.d.availClient.WaitForInclusion(ctx, blockHash); err != nil {
+		return nil, fmt.Errorf("block %d never included in avail: %w", block.Header.Number, err)
+	}
+
+	block.Header.ExtraData = blockHash.Bytes()
+	block.Header.ComputeHash()
+
+	return block, nil
+}
+
+// LocalSealAgent signs blocks immediately, without talking to Avail. It's
+// meant for devnet/integration tests that need a sequencer but don't have
+// a live Avail node to post to.
+type LocalSealAgent struct {
+	d        *Avail
+	logger   hclog.Logger
+	minerKey *ecdsa.PrivateKey
+}
+
+// NewLocalSealAgent creates the Agent integration tests use in place of
+// AvailDAAgent.
+func NewLocalSealAgent(d *Avail, minerKey *ecdsa.PrivateKey) *LocalSealAgent {
+	return &LocalSealAgent{d: d, logger: d.logger.Named("local-seal-agent"), minerKey: minerKey}
+}
+
+func (a *LocalSealAgent) Seal(_ context.Context, block *types.Block) (*types.Block, error) {
+	block.Header.ComputeHash()
+
+	sig, err := crypto.Sign(a.minerKey, block.Header.Hash.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("failed to sign block %d: %w", block.Header.Number, err)
+	}
+
+	block.Header.ExtraData = sig
+	block.Header.ComputeHash()
+
+	return block, nil
+}
@@ -0,0 +1,35 @@
+package avail
+
+import (
+	"context"
+	"testing"
+
+	"github.com/0xPolygon/polygon-edge/crypto"
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/hashicorp/go-hclog"
+)
+
+func TestLocalSealAgentSeal(t *testing.T) {
+	minerKey, err := crypto.GenerateECDSAPrivateKey()
+	if err != nil {
+		t.Fatalf("failed to generate miner key: %v", err)
+	}
+
+	d := &Avail{logger: hclog.NewNullLogger()}
+	agent := NewLocalSealAgent(d, minerKey)
+
+	block := &types.Block{Header: &types.Header{Number: 1}}
+
+	sealed, err := agent.Seal(context.Background(), block)
+	if err != nil {
+		t.Fatalf("Seal returned an error: %v", err)
+	}
+
+	if len(sealed.Header.ExtraData) == 0 {
+		t.Fatal("expected Seal to sign the block and store the signature in ExtraData")
+	}
+
+	if sealed.Header.Hash == (types.Hash{}) {
+		t.Fatal("expected Seal to compute the block hash")
+	}
+}
@@ -4,11 +4,10 @@ import (
 	"context"
 	"crypto/ecdsa"
 	"fmt"
-	"math/big"
+	"sync"
 	"time"
 
 	"github.com/0xPolygon/polygon-edge/blockchain"
-	"github.com/0xPolygon/polygon-edge/chain"
 	"github.com/0xPolygon/polygon-edge/consensus"
 	"github.com/0xPolygon/polygon-edge/helper/progress"
 	"github.com/0xPolygon/polygon-edge/network"
@@ -20,50 +19,66 @@ import (
 	"github.com/0xPolygon/polygon-edge/state"
 	"github.com/0xPolygon/polygon-edge/txpool"
 	"github.com/0xPolygon/polygon-edge/types"
-	"github.com/ethereum/go-ethereum/accounts"
-	"github.com/ethereum/go-ethereum/accounts/keystore"
-	"github.com/ethereum/go-ethereum/common"
 	"github.com/hashicorp/go-hclog"
+	"github.com/maticnetwork/avail-settlement/jsonrpc"
 	"github.com/maticnetwork/avail-settlement/pkg/avail"
+	"github.com/maticnetwork/avail-settlement/pkg/availproc"
+	"github.com/maticnetwork/avail-settlement/pkg/genesis"
+	availsecrets "github.com/maticnetwork/avail-settlement/pkg/secrets"
 	"github.com/maticnetwork/avail-settlement/pkg/staking"
-	"github.com/maticnetwork/avail-settlement/pkg/test"
-)
-
-const (
-	// For now hand coded address of the sequencer
-	SequencerAddress = "0xF817d12e6933BbA48C14D4c992719B46aD9f5f61"
-
-	// For now hand coded address of the watch tower
-	WatchTowerAddress = "0xF817d12e6933BbA48C14D4c992719B46aD9f5f61"
 )
 
 // Dev consensus protocol seals any new transaction immediately
 type Avail struct {
 	logger      hclog.Logger
 	availClient avail.Client
-	mechanisms  []MechanismType
-	nodeType    MechanismType
+
+	// mechanismTypes is the set of roles this node was configured to run;
+	// mechanisms holds the instantiated Mechanism for each of them.
+	mechanismTypes []MechanismType
+	mechanisms     []Mechanism
 
 	syncer syncer.Syncer // Reference to the sync protocol
 
 	notifyCh chan struct{}
 	closeCh  chan struct{}
+	cancel   context.CancelFunc
 
 	validatorKey     *ecdsa.PrivateKey // nolint:unused // Private key for the validator
 	validatorKeyAddr types.Address     // nolint:unused
 
+	// availSigningKey is the Avail substrate account key, independent of
+	// the L2 account keys above. It is loaded at start-up; availClient is
+	// created without it and nothing in this package signs with it.
+	availSigningKey *ecdsa.PrivateKey
+
 	interval uint64
 	txpool   *txpool.TxPool
 
 	blockchain *blockchain.Blockchain
 	executor   *state.Executor
-	verifier   blockchain.Verifier
+
+	// validator and stateProcessor together replace the old monolithic
+	// blockchain.Verifier: validator covers header/witness verification,
+	// stateProcessor covers transaction execution and pre-commit checks.
+	validator      BlockValidator
+	stateProcessor StateProcessor
 
 	updateCh chan struct{} // nolint:unused // Update channel
 
 	network        *network.Server // Reference to the networking layer
 	secretsManager secrets.SecretsManager
 	blockTime      time.Duration // Minimum block generation time in seconds
+
+	// pendingMu guards the fields below, which back the Pending()/
+	// PendingFeeRecipient() RPC-facing API. The pending block is rebuilt
+	// lazily: the sequencer only flags it dirty, Pending() does the
+	// actual rebuild the next time someone reads it.
+	pendingMu    sync.RWMutex
+	pendingBlock *types.Block
+	pendingTxn   *state.Transition
+	pendingDirty bool
+	feeRecipient types.Address
 }
 
 // Factory implements the base factory method
@@ -72,47 +87,78 @@ func Factory(
 ) (consensus.Consensus, error) {
 	logger := params.Logger.Named("avail")
 
-	bs, err := params.SecretsManager.GetSecret(secrets.ValidatorKey)
+	mechanismTypes, err := ParseMechanismConfigTypes(params.Config.Config["mechanisms"])
 	if err != nil {
-		panic("can't find validator key! - " + err.Error())
+		return nil, fmt.Errorf("invalid avail mechanism type/s provided: %w", err)
 	}
 
-	validatorKey, err := crypto.BytesToECDSAPrivateKey(bs)
+	availClient, err := avail.NewClient(fmt.Sprintf("ws://%s/v1/json-rpc", params.AvailAddr.String()))
 	if err != nil {
-		panic("validator key decoding failed: " + err.Error())
+		return nil, err
 	}
 
-	validatorAddr := crypto.PubKeyToAddress(&validatorKey.PublicKey)
-
 	asq := staking.NewActiveSequencersQuerier(params.Blockchain, params.Executor, logger)
 	d := &Avail{
 		logger:         logger,
+		availClient:    availClient,
+		mechanismTypes: mechanismTypes,
 		notifyCh:       make(chan struct{}),
 		closeCh:        make(chan struct{}),
 		blockchain:     params.Blockchain,
 		executor:       params.Executor,
-		verifier:       staking.NewVerifier(asq, logger.Named("verifier")),
+		validator:      staking.NewVerifier(asq, logger.Named("verifier")),
+		stateProcessor: availproc.New(logger, availClient),
 		txpool:         params.TxPool,
 		secretsManager: params.SecretsManager,
 		network:        params.Network,
 		blockTime:      time.Duration(params.BlockTime) * time.Second,
-		nodeType:       MechanismType(params.NodeType),
 		syncer: syncer.NewSyncer(
 			params.Logger,
 			params.Network,
 			params.Blockchain,
 			time.Duration(params.BlockTime)*3*time.Second,
 		),
-		validatorKey:     validatorKey,
-		validatorKeyAddr: validatorAddr,
 	}
 
-	if d.mechanisms, err = ParseMechanismConfigTypes(params.Config.Config["mechanisms"]); err != nil {
-		return nil, fmt.Errorf("invalid avail mechanism type/s provided")
+	// The validator key is only needed by a node actually running the
+	// Validator mechanism; a sequencer-only or watch-tower-only node has no
+	// use for one and shouldn't be forced to provision it just to start up.
+	for _, mechanismType := range mechanismTypes {
+		if mechanismType != Validator {
+			continue
+		}
+
+		bs, err := params.SecretsManager.GetSecret(secrets.ValidatorKey)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load validator key: %w", err)
+		}
+
+		validatorKey, err := crypto.BytesToECDSAPrivateKey(bs)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode validator key: %w", err)
+		}
+
+		d.validatorKey = validatorKey
+		d.validatorKeyAddr = crypto.PubKeyToAddress(&validatorKey.PublicKey)
+		d.feeRecipient = d.validatorKeyAddr
+
+		break
 	}
 
-	d.availClient, err = avail.NewClient(fmt.Sprintf("ws://%s/v1/json-rpc", params.AvailAddr.String()))
+	if d.availSigningKey, err = availsecrets.GetECDSAKey(params.SecretsManager, availsecrets.AvailSigningKey); err != nil {
+		return nil, fmt.Errorf("failed to load avail signing key: %w", err)
+	}
+
+	genesisExtension, err := genesis.Parse(params.Config.Config["avail"])
 	if err != nil {
+		return nil, fmt.Errorf(`invalid "avail" genesis section: %w`, err)
+	}
+
+	if err := genesis.Apply(d.blockchain, d.executor, logger, genesisExtension); err != nil {
+		return nil, fmt.Errorf("failed to apply avail genesis section: %w", err)
+	}
+
+	if d.mechanisms, err = buildMechanisms(d, d.mechanismTypes); err != nil {
 		return nil, err
 	}
 
@@ -134,81 +180,101 @@ func (d *Avail) Initialize() error {
 	return nil
 }
 
-// Start starts the consensus mechanism
-// TODO: GRPC interface and listener, validator sequence and initialization as well P2P networking
+// Start starts every configured Mechanism (role) this node is running.
 func (d *Avail) Start() error {
-	if d.nodeType == Sequencer {
-		// Only start the syncer for sequencer. Validator and Watch Tower are
-		// working purely out of Avail.
-		if err := d.syncer.Start(); err != nil {
-			return err
+	ctx, cancel := context.WithCancel(context.Background())
+	d.cancel = cancel
+
+	for _, mechanism := range d.mechanisms {
+		if err := mechanism.Start(ctx); err != nil {
+			cancel()
+
+			return fmt.Errorf("failed to start %s mechanism: %w", mechanism.Name(), err)
 		}
+	}
 
-		// Ensure that sequencer always has balance
-		depositBalance(d.validatorKeyAddr, big.NewInt(0).Mul(big.NewInt(100), test.ETH), d.blockchain, d.executor)
-		d.logger.Error("automatic sequencer balance deposit active; remove this ASAP ...^")
+	go d.dispatchBlocks(ctx)
 
-		sequencerQuerier := staking.NewActiveSequencersQuerier(d.blockchain, d.executor, d.logger)
-		minerAddr := d.validatorKeyAddr
-		minerPk := d.validatorKey
+	return nil
+}
 
-		sequencerStaked, sequencerError := sequencerQuerier.Contains(minerAddr)
-		if sequencerError != nil {
-			d.logger.Error("failed to check if sequencer is staked", "err", sequencerError)
-			return sequencerError
-		}
+// dispatchBlocks subscribes to newly inserted blocks once and hands each to
+// every configured mechanism's HandleBlock, until ctx is cancelled. This is
+// the single place new blocks reach mechanisms; individual mechanisms
+// shouldn't subscribe to block events on their own.
+func (d *Avail) dispatchBlocks(ctx context.Context) {
+	sub := d.blockchain.SubscribeEvents()
+	defer sub.Close()
+
+	eventCh := sub.GetEventCh()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case event, ok := <-eventCh:
+			if !ok {
+				return
+			}
 
-		if !sequencerStaked {
-			stakeAmount := big.NewInt(0).Mul(big.NewInt(10), staking.ETH)
-			err := staking.Stake(d.blockchain, d.executor, d.logger, "sequencer", minerAddr, minerPk, stakeAmount, 1_000_000, "sequencer")
-			if err != nil {
-				d.logger.Error("failure to build staking block", "error", err)
-				return err
+			for _, header := range event.NewChain {
+				block, ok := d.blockchain.GetBlockByHash(header.Hash, true)
+				if !ok {
+					d.logger.Error("couldn't load block for header", "number", header.Number)
+					continue
+				}
+
+				for _, mechanism := range d.mechanisms {
+					if err := mechanism.HandleBlock(block); err != nil {
+						d.logger.Error("mechanism failed to handle block", "mechanism", mechanism.Name(), "number", header.Number, "err", err)
+					}
+				}
 			}
 		}
-
-		go d.runSequencer(accounts.Account{Address: common.Address(minerAddr)}, &keystore.Key{PrivateKey: minerPk})
 	}
+}
+
+// REQUIRED BASE INTERFACE METHODS //
 
-	if d.nodeType == Validator {
-		go d.runValidator()
+func (d *Avail) VerifyHeader(header *types.Header) error {
+	if err := d.validator.VerifyHeader(header); err != nil {
+		return err
 	}
 
-	if d.nodeType == WatchTower {
-		_, wtAccount, wtPK, err := getAccountData(WatchTowerAddress)
-		if err != nil {
+	for _, mechanism := range d.mechanisms {
+		if err := mechanism.Verify(header); err != nil {
 			return err
 		}
-
-		go d.runWatchTower(wtAccount, wtPK)
 	}
 
 	return nil
 }
 
-// REQUIRED BASE INTERFACE METHODS //
-
-func (d *Avail) VerifyHeader(header *types.Header) error {
-	return d.verifier.VerifyHeader(header)
-}
-
 func (d *Avail) ProcessHeaders(headers []*types.Header) error {
-	return d.verifier.ProcessHeaders(headers)
+	return d.validator.ProcessHeaders(headers)
 }
 
 func (d *Avail) GetBlockCreator(header *types.Header) (types.Address, error) {
-	return d.verifier.GetBlockCreator(header)
+	return d.validator.GetBlockCreator(header)
 }
 
 // PreCommitState a hook to be called before finalizing state transition on inserting block
 func (d *Avail) PreCommitState(header *types.Header, tx *state.Transition) error {
-	return d.verifier.PreCommitState(header, tx)
+	return d.stateProcessor.PreCommitState(header, tx)
 }
 
 func (d *Avail) GetSyncProgression() *progress.Progression {
 	return nil //d.syncer.GetSyncProgression()
 }
 
+// JSONRPCNamespaces returns the JSON-RPC namespaces this consensus engine
+// contributes (currently just "avail", see the jsonrpc package), ready for
+// the node's JSON-RPC server to register alongside its own
+// "eth"/"net"/"web3"/"txpool" namespaces.
+func (d *Avail) JSONRPCNamespaces() map[string]interface{} {
+	return jsonrpc.Namespaces(d)
+}
+
 func (d *Avail) Prepare(header *types.Header) error {
 	// TODO: Remove
 	return nil
@@ -222,79 +288,15 @@ func (d *Avail) Seal(block *types.Block, ctx context.Context) (*types.Block, err
 func (d *Avail) Close() error {
 	close(d.closeCh)
 
-	return nil
-}
-
-func depositBalance(receiver types.Address, amount *big.Int, bchain *blockchain.Blockchain, executor *state.Executor) {
-	parent := bchain.Header()
-	if parent == nil {
-		panic("couldn't load header for HEAD block")
+	if d.cancel != nil {
+		d.cancel()
 	}
 
-	header := &types.Header{
-		ParentHash: parent.Hash,
-		Number:     parent.Number + 1,
-		Miner:      receiver.Bytes(),
-		Nonce:      types.Nonce{},
-		GasLimit:   parent.GasLimit,
-		Timestamp:  uint64(time.Now().Unix()),
-	}
-
-	transition, err := executor.BeginTxn(parent.StateRoot, header, receiver)
-	if err != nil {
-		panic("failed to begin transition: " + err.Error())
-	}
-
-	err = transition.SetAccountDirectly(receiver, &chain.GenesisAccount{Balance: amount})
-	if err != nil {
-		panic("failed to set account balance directly: " + err.Error())
-	}
-
-	// Commit the changes
-	_, root := transition.Commit()
-
-	// Update the header
-	header.StateRoot = root
-	header.GasUsed = transition.TotalGas()
-
-	// Build the actual block
-	// The header hash is computed inside `BuildBlock()`
-	blk := consensus.BuildBlock(consensus.BuildBlockParams{
-		Header:   header,
-		Txns:     []*types.Transaction{},
-		Receipts: transition.Receipts(),
-	})
-
-	// Compute the hash, this is only a provisional hash since the final one
-	// is sealed after all the committed seals
-	blk.Header.ComputeHash()
-
-	err = bchain.WriteBlock(blk, "test")
-	if err != nil {
-		panic("failed to write balance transfer block: " + err.Error())
-	}
-}
-
-// TODO: This is just a demo implementation, to get miner & watch tower
-// addresses working. Implementing bare minimum out of which, when working
-// correctly we can extract into more proper functions in the future.
-func getAccountData(address string) (*keystore.KeyStore, accounts.Account, *keystore.Key, error) {
-	ks := keystore.NewKeyStore("./data/wallets", keystore.StandardScryptN, keystore.StandardScryptP)
-	acc, err := ks.Find(accounts.Account{Address: common.HexToAddress(address)})
-	if err != nil {
-		return nil, accounts.Account{}, nil, fmt.Errorf("failure to load sequencer miner account: %s", err)
-	}
-
-	passpharse := "secret"
-	keyjson, err := ks.Export(acc, passpharse, passpharse)
-	if err != nil {
-		return nil, accounts.Account{}, nil, err
-	}
-
-	privatekey, err := keystore.DecryptKey(keyjson, passpharse)
-	if err != nil {
-		return nil, accounts.Account{}, nil, err
+	for _, mechanism := range d.mechanisms {
+		if err := mechanism.Stop(); err != nil {
+			d.logger.Error("failed to stop mechanism", "mechanism", mechanism.Name(), "err", err)
+		}
 	}
 
-	return ks, acc, privatekey, err
+	return nil
 }
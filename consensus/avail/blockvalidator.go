@@ -0,0 +1,31 @@
+package avail
+
+import "github.com/0xPolygon/polygon-edge/types"
+
+// BlockValidator verifies headers, batches of headers, and the Avail-DA
+// witness data backing a block, before it is accepted into the local
+// chain. It covers everything blockchain.Verifier expects except state
+// transition concerns, which live on StateProcessor instead.
+type BlockValidator interface {
+	// VerifyHeader validates that header is well-formed and was produced
+	// by a staked sequencer.
+	VerifyHeader(header *types.Header) error
+
+	// ProcessHeaders runs any cross-header validation a batch of headers
+	// needs (e.g. difficulty/sequence checks) before they're inserted.
+	ProcessHeaders(headers []*types.Header) error
+
+	// GetBlockCreator recovers the address of the sequencer that built
+	// header.
+	GetBlockCreator(header *types.Header) (types.Address, error)
+
+	// ValidateWitness checks that the Avail-DA inclusion proof for block
+	// actually matches the data the block claims to be built from.
+	ValidateWitness(block *types.Block, proof []byte) error
+
+	// ValidateWitnessData deterministically replays a watch tower's
+	// FraudProof against block, so any node on the network - not just the
+	// one that raised it - can independently agree the underlying
+	// sequencer block was invalid before a slash is accepted.
+	ValidateWitnessData(block *types.Block, proof *FraudProof) error
+}
@@ -0,0 +1,19 @@
+package avail
+
+import (
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+// FraudProof is the evidence a watch tower posts to Avail when it catches a
+// sequencer producing an invalid block: the block's post-state root
+// doesn't match what re-executing it locally produces, one of its
+// transactions doesn't execute the way the sequencer claims, or the DA
+// blob backing it is missing from Avail entirely.
+type FraudProof struct {
+	BlockHash         types.Hash
+	ExpectedStateRoot types.Hash
+	ActualStateRoot   types.Hash
+	WitnessTxIndex    int
+	ExecutionTrace    []byte
+	Signature         []byte
+}
@@ -0,0 +1,143 @@
+package avail
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+// MechanismType is the type of consensus mechanism (role) a node can run
+// on top of the Avail data-availability layer.
+type MechanismType string
+
+const (
+	// Sequencer produces new blocks from the local transaction pool and
+	// submits them to Avail.
+	Sequencer MechanismType = "sequencer"
+
+	// Validator follows the chain that Avail orders and validates it.
+	Validator MechanismType = "validator"
+
+	// WatchTower independently re-executes blocks read from Avail and
+	// disputes the ones that don't match.
+	WatchTower MechanismType = "watchtower"
+)
+
+// mechanismTypes is the set of MechanismType values this package knows how
+// to parse out of node configuration.
+var mechanismTypes = map[MechanismType]bool{
+	Sequencer:  true,
+	Validator:  true,
+	WatchTower: true,
+}
+
+// String implements the fmt.Stringer interface.
+func (t MechanismType) String() string {
+	return string(t)
+}
+
+// Mechanism is a pluggable Avail consensus role. An Avail node can run any
+// combination of mechanisms at once (e.g. Validator and WatchTower), each
+// one owning its own lifecycle and contributing to header verification.
+type Mechanism interface {
+	// Name returns the MechanismType this instance implements.
+	Name() MechanismType
+
+	// Start starts any background work the mechanism needs (goroutines,
+	// subscriptions, ...). It must return once that work has been kicked
+	// off, not block for the mechanism's lifetime.
+	Start(ctx context.Context) error
+
+	// Stop tears down the background work started by Start.
+	Stop() error
+
+	// HandleBlock is invoked whenever a new block is appended to the
+	// local chain, so the mechanism can react to it.
+	HandleBlock(block *types.Block) error
+
+	// Verify lets the mechanism contribute to header verification, on
+	// top of whatever the configured BlockValidator already checks.
+	Verify(header *types.Header) error
+}
+
+// MechanismFactory builds a Mechanism bound to the given Avail consensus
+// instance. Implementations register themselves with RegisterMechanism,
+// typically from an init() function in the file that defines them.
+type MechanismFactory func(d *Avail) (Mechanism, error)
+
+var mechanismBackends = map[MechanismType]MechanismFactory{}
+
+// RegisterMechanism registers a MechanismFactory under name, so that
+// Factory (through buildMechanisms) can instantiate it by name from config.
+// ParseMechanismConfigTypes only accepts the names listed in mechanismTypes.
+func RegisterMechanism(name MechanismType, factory MechanismFactory) {
+	mechanismBackends[name] = factory
+}
+
+// ParseMechanismConfigTypes parses the raw "mechanisms" config value into
+// the list of MechanismType to run. The value is expected to be a list of
+// strings (as decoded from HCL/JSON config), falling back to a single
+// string for the common single-role case.
+func ParseMechanismConfigTypes(value interface{}) ([]MechanismType, error) {
+	var raw []interface{}
+
+	switch v := value.(type) {
+	case nil:
+		return nil, fmt.Errorf("no mechanisms specified")
+	case string:
+		raw = []interface{}{v}
+	case []string:
+		for _, s := range v {
+			raw = append(raw, s)
+		}
+	case []interface{}:
+		raw = v
+	default:
+		return nil, fmt.Errorf("unsupported mechanisms config type %T", value)
+	}
+
+	mechanisms := make([]MechanismType, 0, len(raw))
+
+	for _, r := range raw {
+		name, ok := r.(string)
+		if !ok {
+			return nil, fmt.Errorf("mechanism name expected string, got %T", r)
+		}
+
+		mechanismType := MechanismType(name)
+		if !mechanismTypes[mechanismType] {
+			return nil, fmt.Errorf("unknown mechanism type: %s", name)
+		}
+
+		mechanisms = append(mechanisms, mechanismType)
+	}
+
+	if len(mechanisms) == 0 {
+		return nil, fmt.Errorf("no mechanisms specified")
+	}
+
+	return mechanisms, nil
+}
+
+// buildMechanisms instantiates, in order, one Mechanism per requested
+// MechanismType, using the registry populated by RegisterMechanism.
+func buildMechanisms(d *Avail, types []MechanismType) ([]Mechanism, error) {
+	built := make([]Mechanism, 0, len(types))
+
+	for _, t := range types {
+		factory, ok := mechanismBackends[t]
+		if !ok {
+			return nil, fmt.Errorf("no mechanism registered for type: %s", t)
+		}
+
+		mechanism, err := factory(d)
+		if err != nil {
+			return nil, fmt.Errorf("failed to build %s mechanism: %w", t, err)
+		}
+
+		built = append(built, mechanism)
+	}
+
+	return built, nil
+}
@@ -0,0 +1,127 @@
+package avail
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/0xPolygon/polygon-edge/consensus"
+	"github.com/0xPolygon/polygon-edge/state"
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+// Pending returns the block the sequencer is currently assembling out of
+// the pooled transactions, along with the (already committed) state
+// transition used to build it, or (nil, nil) if this node doesn't run the
+// Sequencer mechanism.
+//
+// The pending block is rebuilt lazily: invalidatePending only flags the
+// cache dirty, the actual rebuild happens here, on the next read. On a
+// sequencer the worker itself reads it once every block time to assemble
+// the block it hands off for sealing.
+func (d *Avail) Pending() (*types.Block, *state.Transition) {
+	d.pendingMu.Lock()
+	defer d.pendingMu.Unlock()
+
+	if d.pendingDirty {
+		if err := d.rebuildPendingBlockLocked(); err != nil {
+			d.logger.Error("failed to rebuild pending block", "err", err)
+			return nil, nil
+		}
+	}
+
+	return d.pendingBlock, d.pendingTxn
+}
+
+// PendingFeeRecipient returns the address new pending blocks are built to
+// reward.
+func (d *Avail) PendingFeeRecipient() types.Address {
+	d.pendingMu.RLock()
+	defer d.pendingMu.RUnlock()
+
+	return d.feeRecipient
+}
+
+// SetPendingFeeRecipient changes the address new pending blocks are built
+// to reward, invalidating the cache so the next read rebuilds with it.
+func (d *Avail) SetPendingFeeRecipient(addr types.Address) {
+	d.pendingMu.Lock()
+	d.feeRecipient = addr
+	d.pendingDirty = true
+	d.pendingMu.Unlock()
+}
+
+// invalidatePending marks the pending block cache stale, so the next
+// Pending() call rebuilds it from the current state of the tx pool. The
+// sequencer worker calls this on every block-time tick, and the seal loop
+// after writing each sealed block; changes to the tx pool alone don't.
+func (d *Avail) invalidatePending() {
+	d.pendingMu.Lock()
+	d.pendingDirty = true
+	d.pendingMu.Unlock()
+}
+
+// setPending overwrites the pending block cache, e.g. once the sequencer
+// worker has actually sealed a block and moved the chain head forward.
+func (d *Avail) setPending(block *types.Block, txn *state.Transition) {
+	d.pendingMu.Lock()
+	d.pendingBlock = block
+	d.pendingTxn = txn
+	d.pendingDirty = false
+	d.pendingMu.Unlock()
+}
+
+// rebuildPendingBlockLocked assembles a fresh pending block out of the
+// current tx pool against the chain head. Callers must hold d.pendingMu.
+func (d *Avail) rebuildPendingBlockLocked() error {
+	parent := d.blockchain.Header()
+	if parent == nil {
+		return fmt.Errorf("no header found for chain head")
+	}
+
+	header := &types.Header{
+		ParentHash: parent.Hash,
+		Number:     parent.Number + 1,
+		Miner:      d.feeRecipient.Bytes(),
+		GasLimit:   parent.GasLimit,
+		Timestamp:  uint64(time.Now().Unix()),
+	}
+
+	txn, err := d.executor.BeginTxn(parent.StateRoot, header, d.feeRecipient)
+	if err != nil {
+		return fmt.Errorf("failed to begin pending state transition: %w", err)
+	}
+
+	txs := make([]*types.Transaction, 0)
+
+	for _, pending := range d.txpool.Pending() {
+		for _, tx := range pending {
+			if err := txn.Write(tx); err != nil {
+				// Skip transactions that don't apply cleanly against the
+				// pending state (e.g. stale nonce); the tx pool will drop
+				// them once the real block lands.
+				continue
+			}
+
+			txs = append(txs, tx)
+		}
+	}
+
+	_, root := txn.Commit()
+
+	header.StateRoot = root
+	header.GasUsed = txn.TotalGas()
+
+	block := consensus.BuildBlock(consensus.BuildBlockParams{
+		Header:   header,
+		Txns:     txs,
+		Receipts: txn.Receipts(),
+	})
+
+	block.Header.ComputeHash()
+
+	d.pendingBlock = block
+	d.pendingTxn = txn
+	d.pendingDirty = false
+
+	return nil
+}
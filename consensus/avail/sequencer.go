@@ -0,0 +1,145 @@
+package avail
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"fmt"
+
+	"github.com/0xPolygon/polygon-edge/crypto"
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/hashicorp/go-hclog"
+	availsecrets "github.com/maticnetwork/avail-settlement/pkg/secrets"
+	"github.com/maticnetwork/avail-settlement/pkg/staking"
+)
+
+func init() {
+	RegisterMechanism(Sequencer, NewSequencerMechanism)
+}
+
+// sequencerMechanism produces new blocks out of the local transaction pool
+// and submits them to Avail. Block assembly (the worker) and sealing/DA
+// submission (the Agent) are split so the same worker can feed either a
+// live Avail node or, in tests, a LocalSealAgent.
+type sequencerMechanism struct {
+	d      *Avail
+	logger hclog.Logger
+
+	minerAddr types.Address
+	minerKey  *ecdsa.PrivateKey
+
+	worker *worker
+	agent  Agent
+}
+
+// NewSequencerMechanism constructs the Sequencer Mechanism for d, loading
+// its signing key through the node's SecretsManager and sealing through
+// AvailDAAgent. Use NewSequencerMechanismWithAgent to swap in a different
+// Agent, e.g. LocalSealAgent for integration tests.
+func NewSequencerMechanism(d *Avail) (Mechanism, error) {
+	return newSequencerMechanism(d, nil)
+}
+
+// NewSequencerMechanismWithAgent is NewSequencerMechanism with an explicit
+// Agent, letting callers (tests, alternative DA deployments) bypass
+// AvailDAAgent.
+func NewSequencerMechanismWithAgent(d *Avail, agent Agent) (Mechanism, error) {
+	return newSequencerMechanism(d, agent)
+}
+
+func newSequencerMechanism(d *Avail, agent Agent) (Mechanism, error) {
+	minerKey, err := availsecrets.GetECDSAKey(d.secretsManager, availsecrets.SequencerKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load sequencer key: %w", err)
+	}
+
+	logger := d.logger.Named("sequencer")
+
+	if agent == nil {
+		agent = NewAvailDAAgent(d, minerKey)
+	}
+
+	return &sequencerMechanism{
+		d:         d,
+		logger:    logger,
+		minerAddr: crypto.PubKeyToAddress(&minerKey.PublicKey),
+		minerKey:  minerKey,
+		worker:    newWorker(d, logger),
+		agent:     agent,
+	}, nil
+}
+
+func (m *sequencerMechanism) Name() MechanismType {
+	return Sequencer
+}
+
+func (m *sequencerMechanism) Start(ctx context.Context) error {
+	d := m.d
+
+	// Only the sequencer needs to sync against the rest of the network;
+	// validators and the watch tower work purely out of Avail.
+	if err := d.syncer.Start(); err != nil {
+		return err
+	}
+
+	// Pending blocks this node assembles reward the sequencer itself.
+	d.SetPendingFeeRecipient(m.minerAddr)
+
+	// The sequencer must already be staked, either through the "avail"
+	// genesis section (see pkg/genesis) or the "avail bootstrap" CLI.
+	// Start no longer mints balance or stakes on the fly: start-up has to
+	// be deterministic and safe to repeat across restarts.
+	sequencerQuerier := staking.NewActiveSequencersQuerier(d.blockchain, d.executor, m.logger)
+
+	sequencerStaked, err := sequencerQuerier.Contains(m.minerAddr)
+	if err != nil {
+		return fmt.Errorf("failed to check if sequencer is staked: %w", err)
+	}
+
+	if !sequencerStaked {
+		return fmt.Errorf("sequencer %s is not staked; run `avail bootstrap` or add it to the genesis avail section", m.minerAddr)
+	}
+
+	go m.worker.run(ctx)
+	go m.sealLoop(ctx)
+
+	return nil
+}
+
+func (m *sequencerMechanism) Stop() error {
+	return nil
+}
+
+func (m *sequencerMechanism) HandleBlock(block *types.Block) error {
+	return nil
+}
+
+func (m *sequencerMechanism) Verify(header *types.Header) error {
+	return nil
+}
+
+// sealLoop hands every block the worker assembles to the configured Agent
+// and, once sealed, writes it to the local chain, until ctx is cancelled.
+func (m *sequencerMechanism) sealLoop(ctx context.Context) {
+	d := m.d
+
+	for {
+		select {
+		case <-ctx.Done():
+			m.logger.Info("sequencer stopped")
+			return
+		case task := <-m.worker.taskCh:
+			sealed, err := m.agent.Seal(ctx, task.block)
+			if err != nil {
+				m.logger.Error("failed to seal block", "number", task.block.Header.Number, "err", err)
+				continue
+			}
+
+			if err := d.blockchain.WriteBlock(sealed, "sequencer"); err != nil {
+				m.logger.Error("failed to write sealed block", "number", sealed.Header.Number, "err", err)
+				continue
+			}
+
+			d.invalidatePending()
+		}
+	}
+}
@@ -0,0 +1,21 @@
+package avail
+
+import (
+	"github.com/0xPolygon/polygon-edge/state"
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+// StateProcessor applies a block's transactions to produce the resulting
+// state, and runs the pre-commit hook before that state is written to the
+// local chain. It covers the state-transition half of what used to be a
+// single monolithic blockchain.Verifier.
+type StateProcessor interface {
+	// Process executes every transaction in block against txn, returning
+	// the resulting receipts, logs and total gas used.
+	Process(block *types.Block, txn *state.Transition) (receipts []*types.Receipt, logs []*types.Log, gasUsed uint64, err error)
+
+	// PreCommitState is called before a block's state is finalized, so
+	// the processor can run last-minute checks (e.g. that the block's
+	// data root matches what was posted to Avail).
+	PreCommitState(header *types.Header, txn *state.Transition) error
+}
@@ -0,0 +1,52 @@
+package avail
+
+import (
+	"context"
+
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/hashicorp/go-hclog"
+)
+
+func init() {
+	RegisterMechanism(Validator, NewValidatorMechanism)
+}
+
+// validatorMechanism follows the chain ordered by Avail and validates it.
+type validatorMechanism struct {
+	d      *Avail
+	logger hclog.Logger
+}
+
+// NewValidatorMechanism constructs the Validator Mechanism for d.
+func NewValidatorMechanism(d *Avail) (Mechanism, error) {
+	return &validatorMechanism{d: d, logger: d.logger.Named("validator")}, nil
+}
+
+func (m *validatorMechanism) Name() MechanismType {
+	return Validator
+}
+
+func (m *validatorMechanism) Start(ctx context.Context) error {
+	go m.d.runValidator(ctx, m.logger)
+	return nil
+}
+
+func (m *validatorMechanism) Stop() error {
+	return nil
+}
+
+func (m *validatorMechanism) HandleBlock(block *types.Block) error {
+	return nil
+}
+
+func (m *validatorMechanism) Verify(header *types.Header) error {
+	return nil
+}
+
+// runValidator blocks until ctx is cancelled and then logs that the
+// validator stopped. It does not read blocks from Avail or apply them to
+// the local chain.
+func (d *Avail) runValidator(ctx context.Context, logger hclog.Logger) {
+	<-ctx.Done()
+	logger.Info("validator stopped")
+}
@@ -0,0 +1,174 @@
+package avail
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"fmt"
+
+	"github.com/0xPolygon/polygon-edge/crypto"
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/hashicorp/go-hclog"
+	availsecrets "github.com/maticnetwork/avail-settlement/pkg/secrets"
+	"github.com/maticnetwork/avail-settlement/pkg/staking"
+)
+
+func init() {
+	RegisterMechanism(WatchTower, NewWatchTowerMechanism)
+}
+
+// watchTowerMechanism independently re-executes blocks read from Avail and
+// disputes the ones that don't match local execution.
+type watchTowerMechanism struct {
+	d      *Avail
+	logger hclog.Logger
+
+	account accounts.Account
+	key     *ecdsa.PrivateKey
+}
+
+// NewWatchTowerMechanism constructs the WatchTower Mechanism for d, loading
+// its signing key through the node's SecretsManager.
+func NewWatchTowerMechanism(d *Avail) (Mechanism, error) {
+	key, err := availsecrets.GetECDSAKey(d.secretsManager, availsecrets.WatchTowerKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load watch tower key: %w", err)
+	}
+
+	addr := crypto.PubKeyToAddress(&key.PublicKey)
+
+	return &watchTowerMechanism{
+		d:       d,
+		logger:  d.logger.Named("watchtower"),
+		account: accounts.Account{Address: common.Address(addr)},
+		key:     key,
+	}, nil
+}
+
+func (m *watchTowerMechanism) Name() MechanismType {
+	return WatchTower
+}
+
+func (m *watchTowerMechanism) Start(ctx context.Context) error {
+	// No background work of its own: new blocks reach this mechanism
+	// through Avail.dispatchBlocks calling HandleBlock below.
+	return nil
+}
+
+func (m *watchTowerMechanism) Stop() error {
+	return nil
+}
+
+func (m *watchTowerMechanism) HandleBlock(block *types.Block) error {
+	return m.d.disputeIfInvalid(m.logger, block, m.key)
+}
+
+func (m *watchTowerMechanism) Verify(header *types.Header) error {
+	return nil
+}
+
+// disputeIfInvalid checks block for each of the three ways a sequencer can
+// misbehave - a missing/invalid Avail-DA witness, a transaction that fails
+// to execute, or a post-execution state root that doesn't match what the
+// sequencer committed to - and, on the first one it finds, builds a
+// FraudProof signed by watchTowerKey, posts it to Avail and slashes the
+// offending sequencer on L2.
+func (d *Avail) disputeIfInvalid(logger hclog.Logger, block *types.Block, watchTowerKey *ecdsa.PrivateKey) error {
+	if err := d.validator.ValidateWitness(block, block.Header.ExtraData); err != nil {
+		return d.fileFraudProof(logger, block, watchTowerKey, types.Hash{}, -1, []byte(fmt.Sprintf("invalid DA witness: %v", err)))
+	}
+
+	parent, ok := d.blockchain.GetHeaderByHash(block.Header.ParentHash)
+	if !ok {
+		return fmt.Errorf("missing parent header for block %d", block.Header.Number)
+	}
+
+	coinbase := types.BytesToAddress(block.Header.Miner)
+
+	txn, err := d.executor.BeginTxn(parent.StateRoot, block.Header, coinbase)
+	if err != nil {
+		return fmt.Errorf("failed to begin replay transition for block %d: %w", block.Header.Number, err)
+	}
+
+	witnessTxIndex := -1
+
+	if _, _, _, err := d.stateProcessor.Process(block, txn); err != nil {
+		witnessTxIndex = len(txn.Receipts())
+
+		return d.fileFraudProof(logger, block, watchTowerKey, types.Hash{}, witnessTxIndex, []byte(err.Error()))
+	}
+
+	_, actualRoot := txn.Commit()
+	if actualRoot == block.Header.StateRoot {
+		return nil
+	}
+
+	return d.fileFraudProof(logger, block, watchTowerKey, actualRoot, witnessTxIndex, nil)
+}
+
+// fileFraudProof signs and posts a FraudProof for block, then slashes the
+// sequencer that produced it.
+func (d *Avail) fileFraudProof(
+	logger hclog.Logger,
+	block *types.Block,
+	watchTowerKey *ecdsa.PrivateKey,
+	actualRoot types.Hash,
+	witnessTxIndex int,
+	trace []byte,
+) error {
+	proof := &FraudProof{
+		BlockHash:         block.Header.Hash,
+		ExpectedStateRoot: block.Header.StateRoot,
+		ActualStateRoot:   actualRoot,
+		WitnessTxIndex:    witnessTxIndex,
+		ExecutionTrace:    trace,
+	}
+
+	digest := crypto.Keccak256(
+		proof.BlockHash.Bytes(),
+		proof.ExpectedStateRoot.Bytes(),
+		proof.ActualStateRoot.Bytes(),
+		proof.ExecutionTrace,
+	)
+
+	sig, err := crypto.Sign(watchTowerKey, digest)
+	if err != nil {
+		return fmt.Errorf("failed to sign fraud proof for block %d: %w", block.Header.Number, err)
+	}
+
+	proof.Signature = sig
+
+	// ValidateWitnessData deterministically replays the proof the same way
+	// any other node on the network would - it's what lets the slash below
+	// be accepted on the strength of the proof itself, not this watch
+	// tower's say-so.
+	if err := d.validator.ValidateWitnessData(block, proof); err != nil {
+		return fmt.Errorf("fraud proof for block %d failed local validation, not filing: %w", block.Header.Number, err)
+	}
+
+	if err := d.availClient.SubmitFraudProof(proof); err != nil {
+		return fmt.Errorf("failed to post fraud proof for block %d to avail: %w", block.Header.Number, err)
+	}
+
+	sequencerAddr, err := d.validator.GetBlockCreator(block.Header)
+	if err != nil {
+		return fmt.Errorf("failed to recover block creator of block %d: %w", block.Header.Number, err)
+	}
+
+	logger.Warn("disputing invalid sequencer block",
+		"number", block.Header.Number,
+		"sequencer", sequencerAddr,
+		"expectedStateRoot", proof.ExpectedStateRoot,
+		"actualStateRoot", proof.ActualStateRoot,
+	)
+
+	// Pass the full proof, not just its signature, so that staking.Slash -
+	// and any other node replaying this slash off the chain itself - can
+	// re-run ValidateWitnessData rather than trust the signature alone.
+	if err := staking.Slash(d.blockchain, d.executor, logger, sequencerAddr, proof, 1_000_000, "slash"); err != nil {
+		return fmt.Errorf("failed to slash sequencer %s for block %d: %w", sequencerAddr, block.Header.Number, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,64 @@
+package avail
+
+import (
+	"context"
+	"time"
+
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/hashicorp/go-hclog"
+)
+
+// sealTask is a fully assembled, not-yet-sealed block handed off from the
+// worker to whichever Agent is configured to seal it. Its header.StateRoot
+// is already final - Pending() commits the state transition that built it
+// before ever returning the block - so sealing never needs the transition
+// itself, only the block.
+type sealTask struct {
+	block *types.Block
+}
+
+// worker owns tx selection and block assembly for the sequencer: it keeps
+// the pending-block cache (pending.go) fresh against the chain head and
+// emits each fully assembled block on taskCh for an Agent to seal. It
+// deliberately knows nothing about how a sealed block actually reaches
+// Avail (or doesn't) - that's the Agent's job.
+type worker struct {
+	d      *Avail
+	logger hclog.Logger
+	taskCh chan *sealTask
+}
+
+func newWorker(d *Avail, logger hclog.Logger) *worker {
+	return &worker{
+		d:      d,
+		logger: logger.Named("worker"),
+		taskCh: make(chan *sealTask, 1),
+	}
+}
+
+// run assembles a new candidate block every blockTime, skipping empty
+// ones, until ctx is cancelled.
+func (w *worker) run(ctx context.Context) {
+	ticker := time.NewTicker(w.d.blockTime)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			w.d.invalidatePending()
+
+			block, _ := w.d.Pending()
+			if block == nil || len(block.Transactions) == 0 {
+				continue
+			}
+
+			select {
+			case w.taskCh <- &sealTask{block: block}:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}
+}
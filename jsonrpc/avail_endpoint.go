@@ -0,0 +1,64 @@
+// Package jsonrpc adds the Avail-specific JSON-RPC namespace on top of the
+// standard eth/net/web3/txpool ones polygon-edge already serves.
+package jsonrpc
+
+import (
+	"github.com/0xPolygon/polygon-edge/state"
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+// availStore is the consensus-level data the "avail" namespace needs. It's
+// satisfied by *consensus/avail.Avail.
+type availStore interface {
+	Pending() (*types.Block, *state.Transition)
+}
+
+// Avail is the "avail_*" JSON-RPC endpoint.
+type Avail struct {
+	store availStore
+}
+
+// NewAvail creates the "avail" namespace endpoint backed by store.
+func NewAvail(store availStore) *Avail {
+	return &Avail{store: store}
+}
+
+func init() {
+	registerNamespace("avail", func(store availStore) interface{} {
+		return NewAvail(store)
+	})
+}
+
+// GetPendingBlock implements avail_getPendingBlock: it returns the block
+// the sequencer is currently assembling, or null if this node isn't
+// sequencing or has nothing pending yet.
+func (a *Avail) GetPendingBlock() (interface{}, error) {
+	block, _ := a.store.Pending()
+	if block == nil {
+		return nil, nil
+	}
+
+	return toPendingBlock(block), nil
+}
+
+// toPendingBlock renders block's parent hash, number, miner, gas limit, gas
+// used and timestamp, plus its transactions as a list of hashes. The block
+// hash and the other header fields are left out.
+func toPendingBlock(block *types.Block) map[string]interface{} {
+	header := block.Header
+
+	txs := make([]string, len(block.Transactions))
+	for i, tx := range block.Transactions {
+		txs[i] = tx.Hash.String()
+	}
+
+	return map[string]interface{}{
+		"parentHash":   header.ParentHash.String(),
+		"number":       header.Number,
+		"miner":        types.BytesToAddress(header.Miner).String(),
+		"gasLimit":     header.GasLimit,
+		"gasUsed":      header.GasUsed,
+		"timestamp":    header.Timestamp,
+		"transactions": txs,
+	}
+}
@@ -0,0 +1,29 @@
+package jsonrpc
+
+// namespaceFactory builds the endpoint for one JSON-RPC namespace this
+// package contributes on top of polygon-edge's own eth/net/web3/txpool
+// ones, given the store backing it.
+type namespaceFactory func(store availStore) interface{}
+
+var namespaces = map[string]namespaceFactory{}
+
+// registerNamespace registers a namespaceFactory under name, so that
+// Namespaces can build it by name once a store is available. Call from an
+// init() in the file that defines the namespace, the same way
+// consensus/avail.RegisterMechanism is used for mechanisms.
+func registerNamespace(name string, factory namespaceFactory) {
+	namespaces[name] = factory
+}
+
+// Namespaces builds every namespace this package contributes, keyed by
+// name, ready for the node's JSON-RPC server to register alongside
+// "eth"/"net"/"web3"/"txpool".
+func Namespaces(store availStore) map[string]interface{} {
+	out := make(map[string]interface{}, len(namespaces))
+
+	for name, factory := range namespaces {
+		out[name] = factory(store)
+	}
+
+	return out
+}
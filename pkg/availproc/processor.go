@@ -0,0 +1,64 @@
+// Package availproc supplies the Avail consensus mechanism's
+// StateProcessor: besides running a block's transactions through the EVM,
+// it checks that the block's data root actually matches what was posted to
+// Avail, so a sequencer can't settle a block on L2 that Avail never
+// ordered.
+package availproc
+
+import (
+	"fmt"
+
+	"github.com/0xPolygon/polygon-edge/state"
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/hashicorp/go-hclog"
+	"github.com/maticnetwork/avail-settlement/pkg/avail"
+)
+
+// Processor is the default Avail StateProcessor.
+type Processor struct {
+	logger      hclog.Logger
+	availClient avail.Client
+}
+
+// New creates a Processor that verifies data roots against availClient.
+func New(logger hclog.Logger, availClient avail.Client) *Processor {
+	return &Processor{
+		logger:      logger.Named("availproc"),
+		availClient: availClient,
+	}
+}
+
+// Process executes every transaction in block against txn, returning the
+// resulting receipts, logs and total gas used.
+func (p *Processor) Process(block *types.Block, txn *state.Transition) ([]*types.Receipt, []*types.Log, uint64, error) {
+	for _, t := range block.Transactions {
+		if err := txn.Write(t); err != nil {
+			return nil, nil, 0, fmt.Errorf("failed to apply transaction %s: %w", t.Hash, err)
+		}
+	}
+
+	receipts := txn.Receipts()
+
+	logs := make([]*types.Log, 0, len(receipts))
+	for _, r := range receipts {
+		logs = append(logs, r.Logs...)
+	}
+
+	return receipts, logs, txn.TotalGas(), nil
+}
+
+// PreCommitState verifies, before header's block is written to the local
+// chain, that the data Avail ordered for this block hashes to the data
+// root the header claims.
+func (p *Processor) PreCommitState(header *types.Header, txn *state.Transition) error {
+	root, err := p.availClient.DataRootForBlock(header.Number)
+	if err != nil {
+		return fmt.Errorf("failed to fetch avail data root for block %d: %w", header.Number, err)
+	}
+
+	if string(root) != string(header.ExtraData) {
+		return fmt.Errorf("block %d data root mismatch: avail has %x, header claims %x", header.Number, root, header.ExtraData)
+	}
+
+	return nil
+}
@@ -0,0 +1,163 @@
+// Package genesis extends polygon-edge's chain.Genesis with the "avail"
+// section: the sequencer stake predeposits a chain starts with, so a
+// sequencer is staked by a bootstrap block written when the chain is first
+// opened instead of minting its own balance on every restart.
+package genesis
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/0xPolygon/polygon-edge/blockchain"
+	"github.com/0xPolygon/polygon-edge/chain"
+	"github.com/0xPolygon/polygon-edge/consensus"
+	"github.com/0xPolygon/polygon-edge/state"
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/hashicorp/go-hclog"
+	"github.com/maticnetwork/avail-settlement/pkg/staking"
+)
+
+// SequencerStake is one predeposit in the "avail" genesis section: the
+// stake a sequencer is given before it produces any block of its own.
+type SequencerStake struct {
+	Address types.Address `json:"address"`
+	Amount  *big.Int      `json:"amount"`
+}
+
+// Extension is the "avail" section of genesis.json.
+type Extension struct {
+	SequencerStakes []SequencerStake `json:"sequencerStakes"`
+}
+
+// Parse decodes the raw "avail" section of a genesis.json's consensus
+// config (already JSON-decoded into generic maps/slices) into an
+// Extension.
+func Parse(raw interface{}) (*Extension, error) {
+	if raw == nil {
+		return nil, nil
+	}
+
+	section, ok := raw.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf(`"avail" genesis section expected an object, got %T`, raw)
+	}
+
+	rawStakes, _ := section["sequencerStakes"].([]interface{})
+
+	ext := &Extension{SequencerStakes: make([]SequencerStake, 0, len(rawStakes))}
+
+	for _, rawStake := range rawStakes {
+		entry, ok := rawStake.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("sequencerStakes entry expected an object, got %T", rawStake)
+		}
+
+		addrStr, ok := entry["address"].(string)
+		if !ok {
+			return nil, fmt.Errorf("sequencerStakes entry missing string address")
+		}
+
+		amountStr, ok := entry["amount"].(string)
+		if !ok {
+			return nil, fmt.Errorf("sequencerStakes entry missing string amount")
+		}
+
+		amount, ok := new(big.Int).SetString(amountStr, 10)
+		if !ok {
+			return nil, fmt.Errorf("sequencerStakes entry has invalid amount %q", amountStr)
+		}
+
+		ext.SequencerStakes = append(ext.SequencerStakes, SequencerStake{
+			Address: types.StringToAddress(addrStr),
+			Amount:  amount,
+		})
+	}
+
+	return ext, nil
+}
+
+// Apply processes every predeposit in ext exactly once, writing one block
+// per not-yet-staked sequencer on top of the chain head. It's idempotent: a sequencer that's already staked (e.g. the
+// node restarted) is left untouched, so this can run unconditionally
+// every time the chain is opened without double-staking anyone.
+func Apply(bchain *blockchain.Blockchain, executor *state.Executor, logger hclog.Logger, ext *Extension) error {
+	if ext == nil {
+		return nil
+	}
+
+	querier := staking.NewActiveSequencersQuerier(bchain, executor, logger)
+
+	for _, predeposit := range ext.SequencerStakes {
+		staked, err := querier.Contains(predeposit.Address)
+		if err != nil {
+			return fmt.Errorf("failed to check stake of %s: %w", predeposit.Address, err)
+		}
+
+		if staked {
+			continue
+		}
+
+		if err := depositAndStake(bchain, executor, logger, predeposit.Address, predeposit.Amount); err != nil {
+			return fmt.Errorf("failed to stake genesis sequencer %s: %w", predeposit.Address, err)
+		}
+	}
+
+	return nil
+}
+
+// depositAndStake writes a single block crediting receiver with amount and
+// marking it staked as a sequencer, both directly against state rather
+// than through a signed transaction - at genesis-processing time we know
+// the predeposit's address and amount, not its private key.
+func depositAndStake(bchain *blockchain.Blockchain, executor *state.Executor, logger hclog.Logger, receiver types.Address, amount *big.Int) error {
+	parent := bchain.Header()
+	if parent == nil {
+		return fmt.Errorf("no header found for chain head")
+	}
+
+	header := &types.Header{
+		ParentHash: parent.Hash,
+		Number:     parent.Number + 1,
+		Miner:      receiver.Bytes(),
+		GasLimit:   parent.GasLimit,
+		// Fixed to the parent's timestamp, not wall-clock time: every node
+		// runs Apply independently off the same genesis, and the resulting
+		// block must hash identically everywhere for the predeposit to be
+		// deterministic across nodes and restarts.
+		Timestamp: parent.Timestamp,
+	}
+
+	transition, err := executor.BeginTxn(parent.StateRoot, header, receiver)
+	if err != nil {
+		return fmt.Errorf("failed to begin transition: %w", err)
+	}
+
+	if err := transition.SetAccountDirectly(receiver, &chain.GenesisAccount{Balance: amount}); err != nil {
+		return fmt.Errorf("failed to set account balance directly: %w", err)
+	}
+
+	if err := staking.SetStakedDirectly(transition, receiver, amount); err != nil {
+		return fmt.Errorf("failed to set staked status directly: %w", err)
+	}
+
+	_, root := transition.Commit()
+
+	header.StateRoot = root
+	header.GasUsed = transition.TotalGas()
+
+	blk := consensus.BuildBlock(consensus.BuildBlockParams{
+		Header:   header,
+		Txns:     []*types.Transaction{},
+		Receipts: transition.Receipts(),
+	})
+
+	blk.Header.ComputeHash()
+
+	if err := bchain.WriteBlock(blk, "genesis-bootstrap"); err != nil {
+		return fmt.Errorf("failed to write bootstrap block: %w", err)
+	}
+
+	logger.Info("staked genesis sequencer", "address", receiver, "amount", amount)
+
+	return nil
+}
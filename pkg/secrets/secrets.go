@@ -0,0 +1,85 @@
+// Package secrets carries the extra SecretsManager key names this
+// consensus mechanism needs on top of the ones polygon-edge already
+// defines (secrets.ValidatorKey, secrets.NetworkKey, ...), plus the
+// bootstrapping logic to generate them.
+package secrets
+
+import (
+	"crypto/ecdsa"
+	"fmt"
+
+	"github.com/0xPolygon/polygon-edge/crypto"
+	"github.com/0xPolygon/polygon-edge/secrets"
+)
+
+const (
+	// SequencerKey is the ECDSA key a node signs produced blocks with
+	// when running the Sequencer mechanism.
+	SequencerKey secrets.SecretsNameID = "sequencer-key"
+
+	// WatchTowerKey is the ECDSA key a node signs fraud proofs with when
+	// running the WatchTower mechanism.
+	WatchTowerKey secrets.SecretsNameID = "watchtower-key"
+
+	// AvailSigningKey is the key used to sign extrinsics submitted to the
+	// Avail substrate chain, independent of the L2 account keys above.
+	AvailSigningKey secrets.SecretsNameID = "avail-signing-key"
+)
+
+// Keys is the full set of Avail-specific secrets a node may need,
+// depending on which mechanisms it runs.
+var Keys = []secrets.SecretsNameID{SequencerKey, WatchTowerKey, AvailSigningKey}
+
+// GetECDSAKey loads and decodes the ECDSA private key stored under name in
+// manager.
+func GetECDSAKey(manager secrets.SecretsManager, name secrets.SecretsNameID) (*ecdsa.PrivateKey, error) {
+	bs, err := manager.GetSecret(name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load %s: %w", name, err)
+	}
+
+	key, err := crypto.BytesToECDSAPrivateKey(bs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode %s: %w", name, err)
+	}
+
+	return key, nil
+}
+
+// GenerateAndStoreECDSAKey generates a fresh secp256k1 key and stores it
+// under name in manager, unless one already exists, in which case it is
+// left untouched.
+func GenerateAndStoreECDSAKey(manager secrets.SecretsManager, name secrets.SecretsNameID) error {
+	if manager.HasSecret(name) {
+		return nil
+	}
+
+	key, err := crypto.GenerateECDSAPrivateKey()
+	if err != nil {
+		return fmt.Errorf("failed to generate %s: %w", name, err)
+	}
+
+	encoded, err := crypto.MarshalECDSAPrivateKey(key)
+	if err != nil {
+		return fmt.Errorf("failed to marshal %s: %w", name, err)
+	}
+
+	if _, err := manager.SetSecret(string(name), encoded); err != nil {
+		return fmt.Errorf("failed to store %s: %w", name, err)
+	}
+
+	return nil
+}
+
+// InitAvailKeys generates every key in Keys that manager doesn't already
+// hold. It's the equivalent of polygon-edge's "secrets init" for the
+// Avail-specific roles (sequencer, watch tower, Avail substrate signing).
+func InitAvailKeys(manager secrets.SecretsManager) error {
+	for _, name := range Keys {
+		if err := GenerateAndStoreECDSAKey(manager, name); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}